@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+// ClusterConditionReady indicates whether the cluster is healthy and ready to
+// accept workloads.
+const ClusterConditionReady = "Ready"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:subresource:status
+
+// Cluster represents a member cluster registered with the Karmada control
+// plane.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the specification of the desired behavior of member cluster.
+	// +required
+	Spec ClusterSpec `json:"spec"`
+
+	// Status represents the status of the member cluster.
+	// +optional
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec represents the specification of the desired behavior of member cluster.
+type ClusterSpec struct {
+	// APIEndpoint is the URL of the member cluster's API server.
+	// +optional
+	APIEndpoint string `json:"apiEndpoint,omitempty"`
+}
+
+// ClusterStatus represents the status of a member cluster.
+type ClusterStatus struct {
+	// Conditions is an array of current cluster conditions.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// RemedyActions records the RemedyActions currently applied to the cluster
+	// by the remedy controller.
+	// +optional
+	RemedyActions []remedyv1alpha1.RemedyAction `json:"remedyActions,omitempty"`
+
+	// RemedyActionStatuses records the outcome of each RemedyAction applied to
+	// the cluster by the remedy controller.
+	// +optional
+	RemedyActionStatuses []remedyv1alpha1.RemedyActionStatus `json:"remedyActionStatuses,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
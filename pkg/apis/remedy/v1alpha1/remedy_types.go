@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RemedyAction represents a remediation action that can be applied to a
+// cluster.
+type RemedyAction string
+
+const (
+	// TrafficControl represents the action of rerouting traffic away from a cluster.
+	TrafficControl RemedyAction = "TrafficControl"
+	// Drain represents the action of draining a cluster's nodes.
+	Drain RemedyAction = "Drain"
+	// Cordon represents the action of marking a cluster's nodes unschedulable.
+	Cordon RemedyAction = "Cordon"
+	// Reboot represents the action of rebooting a cluster's nodes.
+	Reboot RemedyAction = "Reboot"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+
+// Remedy represents the cluster conditions that should be matched and the
+// actions that should be executed when conditions are satisfied.
+type Remedy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the specification of the desired behavior of Remedy.
+	// +required
+	Spec RemedySpec `json:"spec"`
+}
+
+// RemedySpec represents the specification of the desired behavior of Remedy.
+type RemedySpec struct {
+	// ClusterAffinity specifies the clusters that Remedy needs to pay attention to.
+	// For example, if clusterAffinity is not set, any cluster can be selected.
+	// If clusterAffinity is set and a cluster is not matched by it, this cluster
+	// will be ignored when calculating actions.
+	// +optional
+	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// DecisionMatches indicates the decision matches of triggering the Remedy.
+	// As long as any one DecisionMatch is satisfied, the Remedy will be applied.
+	// +optional
+	DecisionMatches []DecisionMatch `json:"decisionMatches,omitempty"`
+
+	// Actions specifies the actions that should be performed when the matching
+	// conditions are satisfied.
+	// +optional
+	Actions []RemedyAction `json:"actions,omitempty"`
+}
+
+// ClusterAffinity represents the filter to select clusters.
+type ClusterAffinity struct {
+	// ClusterNames is the list of clusters to be selected.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+}
+
+// DecisionMatch represents the decision match detail of activating the Remedy.
+type DecisionMatch struct {
+	// ClusterConditionMatch specifies the cluster condition to match.
+	// +optional
+	ClusterConditionMatch *ClusterConditionRequirement `json:"clusterConditionMatch,omitempty"`
+}
+
+// ClusterConditionRequirement describes a Cluster status condition requirement.
+type ClusterConditionRequirement struct {
+	// ConditionType specifies the ClusterStatus condition type.
+	ConditionType string `json:"conditionType"`
+
+	// Operator represents a conditionType's relationship to a conditionStatus.
+	// Valid operators are Equal and NotEqual.
+	Operator ClusterConditionOperator `json:"operator"`
+
+	// ConditionStatus specifies the ClusterStatus condition status.
+	ConditionStatus string `json:"conditionStatus"`
+}
+
+// ClusterConditionOperator is the set of operators that can be used in a
+// cluster condition requirement.
+type ClusterConditionOperator string
+
+const (
+	// ClusterConditionEqual means the actual condition status must equal ConditionStatus.
+	ClusterConditionEqual ClusterConditionOperator = "Equal"
+	// ClusterConditionNotEqual means the actual condition status must differ from ConditionStatus.
+	ClusterConditionNotEqual ClusterConditionOperator = "NotEqual"
+)
+
+// RemedyActionStatus records the outcome of applying a single RemedyAction to
+// a Cluster, so repeated reconciles can tell whether the action already
+// succeeded and how many times it has been retried.
+type RemedyActionStatus struct {
+	// Action is the RemedyAction this status is for.
+	Action RemedyAction `json:"action"`
+
+	// Succeeded indicates whether the action's last execution succeeded.
+	Succeeded bool `json:"succeeded"`
+
+	// Message is a human-readable detail about the last execution, in
+	// particular the error when Succeeded is false.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// RetryCount is the number of consecutive failed executions of the action
+	// since it last succeeded.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// UpdatedAt is the time the action was last executed.
+	// +optional
+	UpdatedAt metav1.Time `json:"updatedAt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RemedyList contains a list of Remedy.
+type RemedyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Remedy `json:"items"`
+}
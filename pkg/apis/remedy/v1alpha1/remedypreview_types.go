@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:subresource:status
+
+// RemedyPreview records, for a single cluster, the RemedyActions a
+// RemedyController running with DryRun enabled would apply, along with the
+// Remedy objects and conditions that produced them. It is named after the
+// Cluster it previews and lets operators validate a new Remedy policy before
+// letting it take effect.
+type RemedyPreview struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status represents the computed preview for the Cluster named by
+	// ObjectMeta.Name.
+	// +optional
+	Status RemedyPreviewStatus `json:"status,omitempty"`
+}
+
+// RemedyPreviewStatus is the observed state of a RemedyPreview.
+type RemedyPreviewStatus struct {
+	// ObservedGeneration is the Cluster generation the preview was computed from.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Actions lists the RemedyActions that would be applied to the cluster.
+	// +optional
+	Actions []RemedyAction `json:"actions,omitempty"`
+
+	// MatchedRemedies lists the names of the Remedy objects that produced Actions.
+	// +optional
+	MatchedRemedies []string `json:"matchedRemedies,omitempty"`
+
+	// Conditions is a snapshot of the cluster's status conditions at the time
+	// the preview was computed.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RemedyPreviewList contains a list of RemedyPreview.
+type RemedyPreviewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RemedyPreview `json:"items"`
+}
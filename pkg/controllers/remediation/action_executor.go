@@ -0,0 +1,181 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+// ActionResult describes the outcome of executing a single RemedyAction
+// against a cluster.
+type ActionResult struct {
+	// Succeeded indicates whether the action was applied successfully.
+	Succeeded bool
+	// Message is a human readable description of the outcome, suitable for
+	// surfacing in a status condition or Event.
+	Message string
+}
+
+// ActionExecutor performs the side effects of a single RemedyAction against
+// cluster, e.g. draining nodes or rerouting traffic.
+type ActionExecutor interface {
+	Execute(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error)
+}
+
+// ActionExecutorFunc adapts a function to an ActionExecutor.
+type ActionExecutorFunc func(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error)
+
+// Execute implements ActionExecutor.
+func (f ActionExecutorFunc) Execute(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	return f(ctx, cluster, action)
+}
+
+// defaultActionExecutors returns the built-in executors for the remedy
+// actions Karmada knows how to perform out of the box, bound to c so they can
+// reach the right cluster client. They are used only when
+// RemedyController.Executors is nil; a non-nil Executors map replaces them
+// entirely rather than being merged with them.
+func defaultActionExecutors(c *RemedyController) map[remedyv1alpha1.RemedyAction]ActionExecutor {
+	return map[remedyv1alpha1.RemedyAction]ActionExecutor{
+		remedyv1alpha1.TrafficControl: ActionExecutorFunc(c.executeTrafficControl),
+		remedyv1alpha1.Drain:          ActionExecutorFunc(c.executeDrain),
+		remedyv1alpha1.Cordon:         ActionExecutorFunc(c.executeCordon),
+		remedyv1alpha1.Reboot:         ActionExecutorFunc(c.executeReboot),
+	}
+}
+
+// executeTrafficControl reroutes traffic away from cluster by stamping an
+// annotation that the multi-cluster ingress controller watches; it does not
+// drive traffic shifting itself.
+func (c *RemedyController) executeTrafficControl(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	return c.annotateCluster(ctx, cluster, "remedy.karmada.io/traffic-control-at", action)
+}
+
+// executeDrain marks cluster for draining by an external node-lifecycle
+// agent, which owns the actual pod eviction.
+func (c *RemedyController) executeDrain(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	return c.annotateCluster(ctx, cluster, "remedy.karmada.io/drain-at", action)
+}
+
+// executeCordon marks cluster as unschedulable for new workloads.
+func (c *RemedyController) executeCordon(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	return c.annotateCluster(ctx, cluster, "remedy.karmada.io/cordon-at", action)
+}
+
+// executeReboot requests an external node-lifecycle agent to reboot cluster's
+// nodes.
+func (c *RemedyController) executeReboot(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	return c.annotateCluster(ctx, cluster, "remedy.karmada.io/reboot-at", action)
+}
+
+// annotateCluster patches cluster with an annotation recording that action
+// was requested. cluster is always the control plane's Cluster object, so
+// the patch always goes through c.Client; an engaged member cluster's API
+// server never has the cluster.karmada.io CRD installed.
+func (c *RemedyController) annotateCluster(ctx context.Context, cluster *clusterv1alpha1.Cluster, key string, action remedyv1alpha1.RemedyAction) (ActionResult, error) {
+	patch := client.MergeFrom(cluster.DeepCopy())
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[key] = time.Now().Format(time.RFC3339)
+	if err := c.Client.Patch(ctx, cluster, patch); err != nil {
+		return ActionResult{}, err
+	}
+	return ActionResult{Succeeded: true, Message: fmt.Sprintf("requested %s via %s", action, key)}, nil
+}
+
+// clientForAction returns the client to use for an executor that targets a
+// resource actually living on the member cluster itself, as opposed to the
+// control plane's Cluster object: the engaged member cluster's client when
+// ctx carries one, the control plane client otherwise. None of the built-in
+// executors target member-cluster-local resources today, so none call this.
+func (c *RemedyController) clientForAction(ctx context.Context) client.Client {
+	if clusterName, ok := clusterNameFromContext(ctx); ok {
+		if cl, ok := c.clusterClient(clusterName); ok {
+			return cl
+		}
+	}
+	return c.Client
+}
+
+// executeActions runs the registered ActionExecutor for each of actions
+// against cluster, returning a RemedyActionStatus per action that records the
+// outcome, a timestamp and a running retry count.
+func (c *RemedyController) executeActions(ctx context.Context, cluster *clusterv1alpha1.Cluster, actions []remedyv1alpha1.RemedyAction) []remedyv1alpha1.RemedyActionStatus {
+	executors := c.Executors
+	if executors == nil {
+		executors = defaultActionExecutors(c)
+	}
+
+	statuses := make([]remedyv1alpha1.RemedyActionStatus, 0, len(actions))
+	for _, action := range actions {
+		statuses = append(statuses, c.executeAction(ctx, cluster, action, executors))
+	}
+	return statuses
+}
+
+func (c *RemedyController) executeAction(ctx context.Context, cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction, executors map[remedyv1alpha1.RemedyAction]ActionExecutor) remedyv1alpha1.RemedyActionStatus {
+	status := remedyv1alpha1.RemedyActionStatus{
+		Action:     action,
+		RetryCount: previousActionRetryCount(cluster, action),
+		UpdatedAt:  metav1.Now(),
+	}
+
+	executor, ok := executors[action]
+	if !ok {
+		status.Message = fmt.Sprintf("no executor registered for action %q", action)
+		c.recordEvent(cluster, corev1.EventTypeWarning, "RemedyActionUnsupported", status.Message)
+		return status
+	}
+
+	result, err := executor.Execute(ctx, cluster, action)
+	if err != nil {
+		status.RetryCount++
+		status.Message = err.Error()
+		c.recordEvent(cluster, corev1.EventTypeWarning, "RemedyActionFailed", fmt.Sprintf("Failed to execute remedy action %s: %v", action, err))
+		return status
+	}
+
+	status.Succeeded = result.Succeeded
+	status.Message = result.Message
+	if result.Succeeded {
+		status.RetryCount = 0
+		c.recordEvent(cluster, corev1.EventTypeNormal, "RemedyActionSucceeded", fmt.Sprintf("Successfully executed remedy action %s: %s", action, result.Message))
+	} else {
+		status.RetryCount++
+		c.recordEvent(cluster, corev1.EventTypeWarning, "RemedyActionFailed", fmt.Sprintf("Remedy action %s did not succeed: %s", action, result.Message))
+	}
+	return status
+}
+
+func previousActionRetryCount(cluster *clusterv1alpha1.Cluster, action remedyv1alpha1.RemedyAction) int32 {
+	for _, status := range cluster.Status.RemedyActionStatuses {
+		if status.Action == action {
+			return status.RetryCount
+		}
+	}
+	return 0
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"testing"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+func TestPreviousActionRetryCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []remedyv1alpha1.RemedyActionStatus
+		action   remedyv1alpha1.RemedyAction
+		want     int32
+	}{
+		{
+			name:     "no previous status",
+			statuses: nil,
+			action:   remedyv1alpha1.Cordon,
+			want:     0,
+		},
+		{
+			name: "matching action returns its retry count",
+			statuses: []remedyv1alpha1.RemedyActionStatus{
+				{Action: remedyv1alpha1.Cordon, RetryCount: 3},
+				{Action: remedyv1alpha1.Drain, RetryCount: 1},
+			},
+			action: remedyv1alpha1.Cordon,
+			want:   3,
+		},
+		{
+			name: "no matching action returns zero",
+			statuses: []remedyv1alpha1.RemedyActionStatus{
+				{Action: remedyv1alpha1.Drain, RetryCount: 5},
+			},
+			action: remedyv1alpha1.Reboot,
+			want:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &clusterv1alpha1.Cluster{Status: clusterv1alpha1.ClusterStatus{RemedyActionStatuses: tt.statuses}}
+			if got := previousActionRetryCount(cluster, tt.action); got != tt.want {
+				t.Errorf("previousActionRetryCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/klog/v2"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EngagedCluster bundles the cache and client scoped to a single member cluster
+// that a ClusterProvider has made available to the controller.
+type EngagedCluster struct {
+	Name   string
+	Cache  ctrlcache.Cache
+	Client client.Client
+}
+
+// ClusterEngagementHandler reacts to member clusters joining or leaving a
+// ClusterProvider.
+type ClusterEngagementHandler interface {
+	// Engage is invoked when cluster becomes available. Implementations must
+	// start any informers they need against cluster.Cache before returning.
+	Engage(ctx context.Context, cluster EngagedCluster) error
+	// Disengage is invoked when cluster is no longer available and must stop
+	// and release anything started by Engage for it.
+	Disengage(ctx context.Context, clusterName string) error
+}
+
+// ClusterProvider discovers member clusters and reports them to a
+// ClusterEngagementHandler as they join or leave the fleet at runtime, so a
+// single karmada-controller-manager instance can evaluate Remedy policies
+// against more clusters than just the Cluster objects it watches in the
+// control plane.
+type ClusterProvider interface {
+	// Run starts the provider. It must block until ctx is cancelled, calling
+	// handler.Engage/Disengage as clusters become available or unavailable.
+	Run(ctx context.Context, handler ClusterEngagementHandler) error
+}
+
+// engagedClusters tracks the clusters currently made available by a
+// ClusterProvider, so Reconcile can look up the client for the cluster named
+// in a request.
+type engagedClusters struct {
+	mu       sync.RWMutex
+	clusters map[string]EngagedCluster
+}
+
+func newEngagedClusters() *engagedClusters {
+	return &engagedClusters{clusters: map[string]EngagedCluster{}}
+}
+
+func (e *engagedClusters) set(cluster EngagedCluster) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.clusters[cluster.Name] = cluster
+}
+
+func (e *engagedClusters) delete(clusterName string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.clusters, clusterName)
+}
+
+func (e *engagedClusters) get(clusterName string) (EngagedCluster, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	cluster, ok := e.clusters[clusterName]
+	return cluster, ok
+}
+
+// Engage records cluster as engaged and starts the informers the controller
+// needs against its cache. It implements ClusterEngagementHandler.
+func (c *RemedyController) Engage(ctx context.Context, cluster EngagedCluster) error {
+	if err := c.engageWatches(ctx, cluster); err != nil {
+		return err
+	}
+	c.engaged.set(cluster)
+	klog.Infof("Engaged cluster(%s) with the remedy controller", cluster.Name)
+	return nil
+}
+
+// Disengage forgets clusterName and stops anything Engage started for it. It
+// implements ClusterEngagementHandler.
+func (c *RemedyController) Disengage(_ context.Context, clusterName string) error {
+	c.disengageWatches(clusterName)
+	c.engaged.delete(clusterName)
+	klog.Infof("Disengaged cluster(%s) from the remedy controller", clusterName)
+	return nil
+}
+
+// clusterClient returns the client for an already engaged member cluster, or
+// false if clusterName is not currently engaged.
+func (c *RemedyController) clusterClient(clusterName string) (client.Client, bool) {
+	cluster, ok := c.engaged.get(clusterName)
+	if !ok {
+		return nil, false
+	}
+	return cluster.Client, true
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// eventsGroup and eventsResource identify the resource the controller needs
+// create/patch access to in order to record remedy action Events.
+const (
+	eventsGroup    = "events.k8s.io"
+	eventsResource = "events"
+)
+
+// checkEventPermissions performs a SelfSubjectAccessReview for create/patch on
+// events.k8s.io in each of namespaces (defaulting to the namespace Events for
+// cluster-scoped objects are recorded in) and returns a real EventRecorder
+// when the permission is present, or nil otherwise. Controllers that run
+// under a least-privilege service account without Events RBAC must not use
+// controller-runtime's default broadcaster, which would spam failed writes;
+// callers should treat a nil result as "don't record events".
+func checkEventPermissions(ctx context.Context, mgr controllerruntime.Manager, namespaces []string) record.EventRecorder {
+	if !hasEventPermissions(ctx, mgr.GetClient(), namespaces) {
+		return nil
+	}
+	return mgr.GetEventRecorderFor(ControllerName)
+}
+
+// hasEventPermissions reports whether c is allowed to create/patch
+// events.k8s.io in every one of namespaces (defaulting to the namespace
+// Events for cluster-scoped objects are recorded in), via a
+// SelfSubjectAccessReview per namespace/verb pair.
+func hasEventPermissions(ctx context.Context, c client.Client, namespaces []string) bool {
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceDefault}
+	}
+
+	for _, namespace := range namespaces {
+		for _, verb := range []string{"create", "patch"} {
+			sar := &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+					ResourceAttributes: &authorizationv1.ResourceAttributes{
+						Namespace: namespace,
+						Verb:      verb,
+						Group:     eventsGroup,
+						Resource:  eventsResource,
+					},
+				},
+			}
+			if err := c.Create(ctx, sar); err != nil {
+				klog.Warningf("Failed to check %s/%s permission for remedy-controller, disabling event recording: %v", eventsGroup, eventsResource, err)
+				return false
+			}
+			if !sar.Status.Allowed {
+				klog.Warningf("Missing permission to %s %s/%s in namespace %q, disabling remedy-controller event recording", verb, eventsGroup, eventsResource, namespace)
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// sarReactorClient is a minimal client.Client stand-in that only implements
+// Create, reacting to SelfSubjectAccessReviews the way a real API server
+// would for the permission being tested; every other method is unused by
+// hasEventPermissions and panics if called.
+type sarReactorClient struct {
+	client.Client
+	allowed bool
+	err     error
+	// namespaces records the namespace of every review received.
+	namespaces []string
+}
+
+func (f *sarReactorClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	if f.err != nil {
+		return f.err
+	}
+	sar := obj.(*authorizationv1.SelfSubjectAccessReview)
+	f.namespaces = append(f.namespaces, sar.Spec.ResourceAttributes.Namespace)
+	sar.Status.Allowed = f.allowed
+	return nil
+}
+
+func TestHasEventPermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		client     client.Client
+		namespaces []string
+		want       bool
+		// wantNamespace, when set, is the only namespace the reviews may target.
+		wantNamespace string
+	}{
+		{
+			name:   "allowed",
+			client: &sarReactorClient{allowed: true},
+			want:   true,
+		},
+		{
+			name:   "denied",
+			client: &sarReactorClient{allowed: false},
+			want:   false,
+		},
+		{
+			name:   "api error",
+			client: &sarReactorClient{err: errors.New("boom")},
+			want:   false,
+		},
+		{
+			name:          "defaults namespaces when empty",
+			client:        &sarReactorClient{allowed: true},
+			namespaces:    nil,
+			want:          true,
+			wantNamespace: metav1.NamespaceDefault,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasEventPermissions(context.Background(), tt.client, tt.namespaces); got != tt.want {
+				t.Errorf("hasEventPermissions() = %v, want %v", got, tt.want)
+			}
+			if tt.wantNamespace != "" {
+				checked := tt.client.(*sarReactorClient).namespaces
+				if len(checked) == 0 {
+					t.Errorf("hasEventPermissions() checked no namespace, want %q", tt.wantNamespace)
+				}
+				for _, namespace := range checked {
+					if namespace != tt.wantNamespace {
+						t.Errorf("hasEventPermissions() checked namespace %q, want %q", namespace, tt.wantNamespace)
+					}
+				}
+			}
+		})
+	}
+}
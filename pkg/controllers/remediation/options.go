@@ -0,0 +1,42 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import "github.com/spf13/pflag"
+
+// Options holds the karmada-controller-manager flags that configure
+// RemedyController. Callers add these to their flag set and copy the parsed
+// values into the corresponding RemedyController fields before calling
+// SetupWithManager.
+type Options struct {
+	// DryRun is the value of the --remedy-dry-run flag; see
+	// RemedyController.DryRun.
+	DryRun bool
+
+	// WatchFilterLabelValue is the value of the --watch-filter-label flag;
+	// see RemedyController.WatchFilterLabelValue.
+	WatchFilterLabelValue string
+}
+
+// AddFlags adds the remedy controller's command line flags to flags.
+func (o *Options) AddFlags(flags *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+	flags.BoolVar(&o.DryRun, "remedy-dry-run", false, "Compute the remedy actions a Remedy policy would take and record them in a RemedyPreview, without applying them.")
+	flags.StringVar(&o.WatchFilterLabelValue, "watch-filter-label", "", "If non-empty, only reconcile Cluster and Remedy objects labeled karmada.io/watch-filter=<value>, so the remedy controller can be sharded across multiple manager instances.")
+}
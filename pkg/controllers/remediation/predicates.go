@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+const (
+	// watchFilterLabelKey is the label checked against --watch-filter-label
+	// so the remedy controller can be sharded across multiple manager
+	// instances, each only reconciling the Cluster/Remedy objects carrying
+	// watchFilterLabelKey=<value>.
+	watchFilterLabelKey = "karmada.io/watch-filter"
+	// pausedAnnotationKey marks a Cluster or Remedy object the controller
+	// should skip reconciling.
+	pausedAnnotationKey = "remedy.karmada.io/paused"
+)
+
+// watchFilterPredicate returns a predicate that only admits objects carrying
+// watchFilterLabelKey=value. An empty value admits every object, so the
+// predicate is a no-op unless --watch-filter-label is set.
+func watchFilterPredicate(value string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		if value == "" {
+			return true
+		}
+		return obj.GetLabels()[watchFilterLabelKey] == value
+	})
+}
+
+// notPausedPredicate returns a predicate that skips objects annotated with
+// pausedAnnotationKey="true".
+func notPausedPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetAnnotations()[pausedAnnotationKey] != "true"
+	})
+}
+
+// clusterStatusChangedPredicate skips Cluster updates whose status
+// conditions didn't change, avoiding pointless recomputation of
+// calculateActions on updates that only touch, say, resourceVersion or
+// unrelated status fields.
+func clusterStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return true
+			}
+			newCluster, ok := e.ObjectNew.(*clusterv1alpha1.Cluster)
+			if !ok {
+				return true
+			}
+			return !reflect.DeepEqual(oldCluster.Status.Conditions, newCluster.Status.Conditions)
+		},
+	}
+}
+
+// remedySpecChangedPredicate skips Remedy updates that leave Spec unchanged,
+// e.g. status or metadata-only no-op updates.
+func remedySpecChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRemedy, ok := e.ObjectOld.(*remedyv1alpha1.Remedy)
+			if !ok {
+				return true
+			}
+			newRemedy, ok := e.ObjectNew.(*remedyv1alpha1.Remedy)
+			if !ok {
+				return true
+			}
+			return !reflect.DeepEqual(oldRemedy.Spec, newRemedy.Spec)
+		},
+	}
+}
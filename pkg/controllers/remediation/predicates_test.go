@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+func TestWatchFilterPredicate(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "empty filter admits unlabelled object", value: "", labels: nil, want: true},
+		{name: "empty filter admits labelled object", value: "", labels: map[string]string{watchFilterLabelKey: "shard-a"}, want: true},
+		{name: "matching label admitted", value: "shard-a", labels: map[string]string{watchFilterLabelKey: "shard-a"}, want: true},
+		{name: "mismatched label rejected", value: "shard-a", labels: map[string]string{watchFilterLabelKey: "shard-b"}, want: false},
+		{name: "missing label rejected", value: "shard-a", labels: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Labels: tt.labels}}
+			got := watchFilterPredicate(tt.value).Create(event.CreateEvent{Object: obj})
+			if got != tt.want {
+				t.Errorf("watchFilterPredicate(%q).Create() = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotPausedPredicate(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "unannotated object admitted", annotations: nil, want: true},
+		{name: "paused object rejected", annotations: map[string]string{pausedAnnotationKey: "true"}, want: false},
+		{name: "non-true value admitted", annotations: map[string]string{pausedAnnotationKey: "false"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			got := notPausedPredicate().Create(event.CreateEvent{Object: obj})
+			if got != tt.want {
+				t.Errorf("notPausedPredicate().Create() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterStatusChangedPredicate(t *testing.T) {
+	tests := []struct {
+		name string
+		old  *clusterv1alpha1.Cluster
+		new  *clusterv1alpha1.Cluster
+		want bool
+	}{
+		{
+			name: "unchanged conditions skipped",
+			old:  &clusterv1alpha1.Cluster{Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}}}},
+			new:  &clusterv1alpha1.Cluster{Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}}}},
+			want: false,
+		},
+		{
+			name: "changed conditions admitted",
+			old:  &clusterv1alpha1.Cluster{Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue}}}},
+			new:  &clusterv1alpha1.Cluster{Status: clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse}}}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clusterStatusChangedPredicate().Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new})
+			if got != tt.want {
+				t.Errorf("clusterStatusChangedPredicate().Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemedySpecChangedPredicate(t *testing.T) {
+	tests := []struct {
+		name string
+		old  *remedyv1alpha1.Remedy
+		new  *remedyv1alpha1.Remedy
+		want bool
+	}{
+		{
+			name: "unchanged spec skipped",
+			old:  &remedyv1alpha1.Remedy{Spec: remedyv1alpha1.RemedySpec{Actions: []remedyv1alpha1.RemedyAction{remedyv1alpha1.Cordon}}},
+			new:  &remedyv1alpha1.Remedy{Spec: remedyv1alpha1.RemedySpec{Actions: []remedyv1alpha1.RemedyAction{remedyv1alpha1.Cordon}}},
+			want: false,
+		},
+		{
+			name: "changed spec admitted",
+			old:  &remedyv1alpha1.Remedy{Spec: remedyv1alpha1.RemedySpec{Actions: []remedyv1alpha1.RemedyAction{remedyv1alpha1.Cordon}}},
+			new:  &remedyv1alpha1.Remedy{Spec: remedyv1alpha1.RemedySpec{Actions: []remedyv1alpha1.RemedyAction{remedyv1alpha1.Drain}}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := remedySpecChangedPredicate().Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new})
+			if got != tt.want {
+				t.Errorf("remedySpecChangedPredicate().Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
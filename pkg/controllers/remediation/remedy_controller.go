@@ -18,16 +18,25 @@ package remediation
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"sync"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
@@ -44,6 +53,61 @@ const ControllerName = "remedy-controller"
 type RemedyController struct {
 	client.Client
 	RateLimitOptions ratelimiterflag.Options
+
+	// DryRun indicates the controller only computes the RemedyActions a cluster
+	// would receive and records them in a RemedyPreview, without applying the
+	// changes to cluster.Status.RemedyActions. It allows operators to validate
+	// a new Remedy policy before letting it take effect. Backed by the
+	// --remedy-dry-run flag; see Options.
+	DryRun bool
+
+	// ClusterProvider, when set, engages member clusters dynamically at runtime
+	// (e.g. as they join or leave the fleet) so Remedy policies can be evaluated
+	// against more clusters than the Cluster objects watched in the control
+	// plane. It is optional; when nil the controller only reacts to the
+	// control plane's Cluster and Remedy objects, as before.
+	ClusterProvider ClusterProvider
+
+	// Executors maps a RemedyAction to the ActionExecutor that performs it.
+	// When nil, the built-in executors returned by defaultActionExecutors are
+	// used.
+	Executors map[remedyv1alpha1.RemedyAction]ActionExecutor
+
+	// Recorder emits Kubernetes Events for remedy action outcomes. It may be
+	// nil, in which case no events are recorded. SetupWithManager populates
+	// it automatically based on checkEventPermissions unless already set.
+	Recorder record.EventRecorder
+
+	// EventNamespaces lists the namespaces checked for events.k8s.io
+	// create/patch permission during SetupWithManager. If empty, the
+	// namespace Events for cluster-scoped objects are recorded in is checked.
+	EventNamespaces []string
+
+	// WatchFilterLabelValue, when non-empty, restricts reconciliation to
+	// Cluster and Remedy objects carrying the
+	// "karmada.io/watch-filter=<value>" label, letting the remedy controller
+	// be sharded across multiple manager instances. Backed by the
+	// --watch-filter-label flag; see Options.
+	WatchFilterLabelValue string
+
+	controller    controller.Controller
+	engaged       *engagedClusters
+	mu            sync.Mutex
+	memberWatches map[string]*memberWatch
+	// memberEvents carries synthetic Cluster events derived from node changes
+	// observed on engaged member clusters, so they flow through the single
+	// channel watch registered once in setupWatches instead of each engaged
+	// cluster registering (and leaking) its own Watch call.
+	memberEvents chan event.GenericEvent
+}
+
+// memberWatch tracks the informer registration engageWatches added to an
+// engaged member cluster's cache, so disengageWatches can remove it cleanly
+// instead of leaving it registered for the life of the process.
+type memberWatch struct {
+	cancel       context.CancelFunc
+	informer     ctrlcache.Informer
+	registration toolscache.ResourceEventHandlerRegistration
 }
 
 // Reconcile performs a full reconciliation for the object referred to by the Request.
@@ -51,8 +115,17 @@ type RemedyController struct {
 // Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
 func (c *RemedyController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
 	klog.V(4).Infof("Start to reconcile cluster(%s)", req.NamespacedName.String())
+	// Requests originating from an engaged member cluster (see ClusterProvider)
+	// carry the cluster's name in the request Namespace, since Cluster is a
+	// cluster-scoped resource and the field would otherwise be unused. Thread
+	// it through the context so remedy action execution can dispatch to the
+	// right member cluster client.
+	if req.Namespace != "" {
+		ctx = withClusterName(ctx, req.Namespace)
+	}
+
 	cluster := &clusterv1alpha1.Cluster{}
-	if err := c.Client.Get(ctx, req.NamespacedName, cluster); err != nil {
+	if err := c.Client.Get(ctx, types.NamespacedName{Name: req.Name}, cluster); err != nil {
 		if apierrors.IsNotFound(err) {
 			return controllerruntime.Result{}, nil
 		}
@@ -63,6 +136,24 @@ func (c *RemedyController) Reconcile(ctx context.Context, req controllerruntime.
 		return controllerruntime.Result{}, nil
 	}
 
+	// The memberEvents watch (see setupWatches) can't apply watchFilter at
+	// the source, since the synthetic events it carries only know the
+	// cluster's name, not its labels; re-check the real object's label here
+	// so a sharded controller doesn't reconcile Clusters outside its shard.
+	if c.WatchFilterLabelValue != "" && cluster.Labels[watchFilterLabelKey] != c.WatchFilterLabelValue {
+		return controllerruntime.Result{}, nil
+	}
+
+	if cluster.Annotations[pausedAnnotationKey] == "true" {
+		klog.V(4).Infof("Cluster(%s) is paused, skipping remedy reconciliation", cluster.Name)
+		return controllerruntime.Result{}, nil
+	}
+
+	if err := c.refreshFromEngagedCluster(ctx, cluster); err != nil {
+		klog.Errorf("Failed to refresh cluster(%s) status from its engaged member cluster: %v", cluster.Name, err)
+		return controllerruntime.Result{}, err
+	}
+
 	clusterRelatedRemedies, err := c.getClusterRelatedRemedies(ctx, cluster)
 	if err != nil {
 		klog.Errorf("Failed to get cluster(%s) related remedies: %v", cluster.Name, err)
@@ -70,11 +161,23 @@ func (c *RemedyController) Reconcile(ctx context.Context, req controllerruntime.
 	}
 
 	actions := calculateActions(clusterRelatedRemedies, cluster)
+	if c.DryRun {
+		if err := c.syncRemedyPreview(ctx, cluster, clusterRelatedRemedies, actions); err != nil {
+			klog.Errorf("Failed to sync remedy preview for cluster(%s): %v", cluster.Name, err)
+			return controllerruntime.Result{}, err
+		}
+		klog.V(4).Infof("Success to preview cluster(%s) remedy actions: %v", cluster.Name, actions)
+		return controllerruntime.Result{}, nil
+	}
+
+	actionStatuses := c.executeActions(ctx, cluster, actions)
+
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		if reflect.DeepEqual(actions, cluster.Status.RemedyActions) {
+		if reflect.DeepEqual(actions, cluster.Status.RemedyActions) && reflect.DeepEqual(actionStatuses, cluster.Status.RemedyActionStatuses) {
 			return nil
 		}
 		cluster.Status.RemedyActions = actions
+		cluster.Status.RemedyActionStatuses = actionStatuses
 		updateErr := c.Client.Status().Update(ctx, cluster)
 		if updateErr == nil {
 			return nil
@@ -93,10 +196,27 @@ func (c *RemedyController) Reconcile(ctx context.Context, req controllerruntime.
 		klog.Errorf("Failed to sync cluster(%s) remedy actions: %v", cluster.Name, err)
 		return controllerruntime.Result{}, err
 	}
+
+	for _, status := range actionStatuses {
+		if !status.Succeeded {
+			klog.Errorf("Remedy action %s did not succeed on cluster(%s): %s", status.Action, cluster.Name, status.Message)
+			return controllerruntime.Result{}, fmt.Errorf("remedy action %s did not succeed on cluster %s: %s", status.Action, cluster.Name, status.Message)
+		}
+	}
+
 	klog.V(4).Infof("Success to sync cluster(%s) remedy actions: %v", cluster.Name, actions)
 	return controllerruntime.Result{}, nil
 }
 
+// recordEvent emits a Kubernetes Event for cluster if a Recorder is
+// configured; it is a no-op otherwise, e.g. when Events RBAC is unavailable.
+func (c *RemedyController) recordEvent(cluster *clusterv1alpha1.Cluster, eventType, reason, message string) {
+	if c.Recorder == nil {
+		return
+	}
+	c.Recorder.Event(cluster, eventType, reason, message)
+}
+
 func (c *RemedyController) getClusterRelatedRemedies(ctx context.Context, cluster *clusterv1alpha1.Cluster) ([]*remedyv1alpha1.Remedy, error) {
 	remedyList := &remedyv1alpha1.RemedyList{}
 	if err := c.Client.List(ctx, remedyList); err != nil {
@@ -106,6 +226,9 @@ func (c *RemedyController) getClusterRelatedRemedies(ctx context.Context, cluste
 	var clusterRelatedRemedies []*remedyv1alpha1.Remedy
 	for index := range remedyList.Items {
 		remedy := remedyList.Items[index]
+		if remedy.Annotations[pausedAnnotationKey] == "true" {
+			continue
+		}
 		if isRemedyWorkOnCluster(&remedy, cluster) {
 			clusterRelatedRemedies = append(clusterRelatedRemedies, &remedy)
 		}
@@ -122,11 +245,24 @@ func (c *RemedyController) SetupWithManager(mgr controllerruntime.Manager) error
 	if err != nil {
 		return err
 	}
+	c.controller = remedyController
+	c.engaged = newEngagedClusters()
+	if c.Recorder == nil {
+		c.Recorder = checkEventPermissions(context.TODO(), mgr, c.EventNamespaces)
+	}
 
 	err = c.setupWatches(remedyController, mgr)
 	if err != nil {
 		return err
 	}
+
+	if c.ClusterProvider != nil {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return c.ClusterProvider.Run(ctx, c)
+		})); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -134,15 +270,171 @@ func (c *RemedyController) setupWatches(remedyController controller.Controller,
 	clusterChan := make(chan event.GenericEvent)
 	clusterHandler := newClusterEventHandler()
 	remedyHandler := newRemedyEventHandler(clusterChan, c.Client)
+	c.memberEvents = make(chan event.GenericEvent)
 
-	if err := remedyController.Watch(source.Kind(mgr.GetCache(), &clusterv1alpha1.Cluster{}), clusterHandler); err != nil {
+	watchFilter := watchFilterPredicate(c.WatchFilterLabelValue)
+	notPaused := notPausedPredicate()
+
+	if err := remedyController.Watch(source.Kind(mgr.GetCache(), &clusterv1alpha1.Cluster{}), clusterHandler, watchFilter, notPaused, clusterStatusChangedPredicate()); err != nil {
+		return err
+	}
+	if err := remedyController.Watch(&source.Channel{Source: clusterChan}, clusterHandler, watchFilter, notPaused); err != nil {
 		return err
 	}
-	if err := remedyController.Watch(&source.Channel{Source: clusterChan}, clusterHandler); err != nil {
+	// memberEvents carries synthetic Cluster events derived from engaged
+	// member clusters (see engageWatches); registering this watch once here,
+	// rather than re-registering a Watch per engaged cluster, avoids
+	// accumulating dead watch sources as clusters join and leave the fleet.
+	// enqueueMemberCluster only knows the cluster's name, not its labels, so
+	// watchFilter is deliberately omitted here - it would reject every
+	// synthetic event outright once --watch-filter-label is set. Reconcile
+	// re-applies the filter itself once it has read the real Cluster object.
+	if err := remedyController.Watch(&source.Channel{Source: c.memberEvents}, clusterHandler, notPaused); err != nil {
 		return err
 	}
-	if err := remedyController.Watch(source.Kind(mgr.GetCache(), &remedyv1alpha1.Remedy{}), remedyHandler); err != nil {
+	if err := remedyController.Watch(source.Kind(mgr.GetCache(), &remedyv1alpha1.Remedy{}), remedyHandler, watchFilter, notPaused, remedySpecChangedPredicate()); err != nil {
 		return err
 	}
 	return nil
 }
+
+// engageWatches starts the member cluster's cache and begins watching its
+// Node objects, the signal the remedy controller remediates on, pushing a
+// synthetic Cluster event onto c.memberEvents whenever a Node is added,
+// updated or deleted so Reconcile re-evaluates the cluster.
+func (c *RemedyController) engageWatches(ctx context.Context, cluster EngagedCluster) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.memberWatches == nil {
+		c.memberWatches = map[string]*memberWatch{}
+	}
+	if _, exists := c.memberWatches[cluster.Name]; exists {
+		return nil
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := cluster.Cache.Start(watchCtx); err != nil {
+			klog.Errorf("Cache for cluster(%s) stopped: %v", cluster.Name, err)
+		}
+	}()
+	if !cluster.Cache.WaitForCacheSync(watchCtx) {
+		cancel()
+		return fmt.Errorf("failed to sync cache for cluster %s", cluster.Name)
+	}
+
+	informer, err := cluster.Cache.GetInformer(watchCtx, &corev1.Node{})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to get node informer for cluster %s: %w", cluster.Name, err)
+	}
+	registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { c.enqueueMemberCluster(cluster.Name) },
+		UpdateFunc: func(interface{}, interface{}) { c.enqueueMemberCluster(cluster.Name) },
+		DeleteFunc: func(interface{}) { c.enqueueMemberCluster(cluster.Name) },
+	})
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to watch nodes for cluster %s: %w", cluster.Name, err)
+	}
+
+	c.memberWatches[cluster.Name] = &memberWatch{cancel: cancel, informer: informer, registration: registration}
+	return nil
+}
+
+// disengageWatches removes the node informer registration added by
+// engageWatches for clusterName and stops its cache, so a churny fleet
+// doesn't accumulate dead watch registrations over the life of the process.
+func (c *RemedyController) disengageWatches(clusterName string) {
+	c.mu.Lock()
+	watch, ok := c.memberWatches[clusterName]
+	if ok {
+		delete(c.memberWatches, clusterName)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if err := watch.informer.RemoveEventHandler(watch.registration); err != nil {
+		klog.Errorf("Failed to remove node watch for cluster(%s): %v", clusterName, err)
+	}
+	watch.cancel()
+}
+
+// enqueueMemberCluster pushes a synthetic Cluster event for clusterName onto
+// c.memberEvents, carrying clusterName in both fields of the NamespacedName
+// so clusterHandler's resulting Request lets Reconcile recover it via
+// req.Namespace (see withClusterName).
+func (c *RemedyController) enqueueMemberCluster(clusterName string) {
+	c.memberEvents <- event.GenericEvent{
+		Object: &clusterv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: clusterName},
+		},
+	}
+}
+
+// refreshFromEngagedCluster merges live Node health observed on cluster's
+// engaged member cluster into cluster.Status.Conditions, so Remedy policy is
+// evaluated against the member cluster's own data rather than only whatever
+// the control plane last observed. It is a no-op unless ctx carries an
+// engaged cluster name (see withClusterName) that is currently engaged.
+func (c *RemedyController) refreshFromEngagedCluster(ctx context.Context, cluster *clusterv1alpha1.Cluster) error {
+	clusterName, ok := clusterNameFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	memberClient, ok := c.clusterClient(clusterName)
+	if !ok {
+		return nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := memberClient.List(ctx, nodes); err != nil {
+		return fmt.Errorf("failed to list nodes on cluster %s: %w", clusterName, err)
+	}
+
+	status := metav1.ConditionTrue
+	if !allNodesReady(nodes.Items) {
+		status = metav1.ConditionFalse
+	}
+	meta.SetStatusCondition(&cluster.Status.Conditions, metav1.Condition{
+		Type:    clusterv1alpha1.ClusterConditionReady,
+		Status:  status,
+		Reason:  "NodeStatus",
+		Message: fmt.Sprintf("observed %d node(s) on the engaged member cluster", len(nodes.Items)),
+	})
+	return nil
+}
+
+// allNodesReady reports whether every node carries a true NodeReady condition.
+func allNodesReady(nodes []corev1.Node) bool {
+	for _, node := range nodes {
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				ready = true
+				break
+			}
+		}
+		if !ready {
+			return false
+		}
+	}
+	return true
+}
+
+type clusterNameContextKey struct{}
+
+// withClusterName returns a copy of ctx carrying the name of the engaged
+// member cluster a reconcile request originated from.
+func withClusterName(ctx context.Context, clusterName string) context.Context {
+	return context.WithValue(ctx, clusterNameContextKey{}, clusterName)
+}
+
+// clusterNameFromContext returns the engaged member cluster name carried by
+// ctx, if any.
+func clusterNameFromContext(ctx context.Context) (string, bool) {
+	clusterName, ok := ctx.Value(clusterNameContextKey{}).(string)
+	return clusterName, ok && clusterName != ""
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+)
+
+// fakeInformer is a minimal ctrlcache.Informer stand-in that only implements
+// AddEventHandler/RemoveEventHandler, recording enough to let tests drive
+// the handler funcs and assert on removal.
+type fakeInformer struct {
+	ctrlcache.Informer
+	handler toolscache.ResourceEventHandler
+	removed toolscache.ResourceEventHandlerRegistration
+}
+
+func (f *fakeInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	f.handler = handler
+	return fakeRegistration{}, nil
+}
+
+func (f *fakeInformer) RemoveEventHandler(reg toolscache.ResourceEventHandlerRegistration) error {
+	f.removed = reg
+	return nil
+}
+
+type fakeRegistration struct{}
+
+func (fakeRegistration) HasSynced() bool { return true }
+
+// fakeEngagedCache is a minimal ctrlcache.Cache stand-in covering only what
+// engageWatches calls.
+type fakeEngagedCache struct {
+	ctrlcache.Cache
+	informer *fakeInformer
+}
+
+func (f *fakeEngagedCache) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (f *fakeEngagedCache) WaitForCacheSync(context.Context) bool { return true }
+
+func (f *fakeEngagedCache) GetInformer(context.Context, client.Object, ...ctrlcache.InformerGetOption) (ctrlcache.Informer, error) {
+	return f.informer, nil
+}
+
+func TestEngageAndDisengageWatches(t *testing.T) {
+	informer := &fakeInformer{}
+	c := &RemedyController{memberEvents: make(chan event.GenericEvent, 1)}
+	cluster := EngagedCluster{Name: "member-1", Cache: &fakeEngagedCache{informer: informer}}
+
+	if err := c.engageWatches(context.Background(), cluster); err != nil {
+		t.Fatalf("engageWatches() error = %v", err)
+	}
+	watch, ok := c.memberWatches["member-1"]
+	if !ok {
+		t.Fatal("engageWatches() did not register a memberWatch for member-1")
+	}
+	if informer.handler == nil {
+		t.Fatal("engageWatches() did not add a node event handler")
+	}
+
+	// Re-engaging an already-engaged cluster must be a no-op rather than
+	// registering a second handler.
+	if err := c.engageWatches(context.Background(), cluster); err != nil {
+		t.Fatalf("engageWatches() on already-engaged cluster error = %v", err)
+	}
+	if len(c.memberWatches) != 1 {
+		t.Fatalf("memberWatches has %d entries, want 1", len(c.memberWatches))
+	}
+
+	informer.handler.OnAdd(&corev1.Node{}, false)
+	select {
+	case evt := <-c.memberEvents:
+		if evt.Object.GetName() != "member-1" {
+			t.Errorf("enqueued event name = %q, want %q", evt.Object.GetName(), "member-1")
+		}
+	default:
+		t.Fatal("expected a synthetic event on memberEvents after a node Add")
+	}
+
+	c.disengageWatches("member-1")
+	if _, ok := c.memberWatches["member-1"]; ok {
+		t.Error("disengageWatches() did not remove the memberWatch entry")
+	}
+	if informer.removed == nil {
+		t.Error("disengageWatches() did not remove the node event handler")
+	}
+	if watch.cancel == nil {
+		t.Error("engageWatches() did not record a cancel func for the watch")
+	}
+}
+
+// fakeNodeListClient is a minimal client.Client stand-in that serves a fixed
+// NodeList, the only thing refreshFromEngagedCluster calls.
+type fakeNodeListClient struct {
+	client.Client
+	nodes []corev1.Node
+}
+
+func (f *fakeNodeListClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	list.(*corev1.NodeList).Items = f.nodes
+	return nil
+}
+
+func TestRefreshFromEngagedCluster(t *testing.T) {
+	readyNode := corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}}}
+	notReadyNode := corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}}}
+
+	tests := []struct {
+		name          string
+		engageContext bool
+		nodes         []corev1.Node
+		wantStatus    metav1.ConditionStatus
+	}{
+		{name: "not engaged is a no-op", engageContext: false},
+		{name: "all nodes ready", engageContext: true, nodes: []corev1.Node{readyNode}, wantStatus: metav1.ConditionTrue},
+		{name: "a node not ready", engageContext: true, nodes: []corev1.Node{readyNode, notReadyNode}, wantStatus: metav1.ConditionFalse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &RemedyController{engaged: newEngagedClusters()}
+			c.engaged.set(EngagedCluster{Name: "member-1", Client: &fakeNodeListClient{nodes: tt.nodes}})
+
+			ctx := context.Background()
+			if tt.engageContext {
+				ctx = withClusterName(ctx, "member-1")
+			}
+			cluster := &clusterv1alpha1.Cluster{}
+			if err := c.refreshFromEngagedCluster(ctx, cluster); err != nil {
+				t.Fatalf("refreshFromEngagedCluster() error = %v", err)
+			}
+
+			if !tt.engageContext {
+				if len(cluster.Status.Conditions) != 0 {
+					t.Errorf("expected no condition to be set, got %v", cluster.Status.Conditions)
+				}
+				return
+			}
+			if len(cluster.Status.Conditions) != 1 {
+				t.Fatalf("expected exactly one condition, got %v", cluster.Status.Conditions)
+			}
+			if got := cluster.Status.Conditions[0].Status; got != tt.wantStatus {
+				t.Errorf("Ready condition status = %v, want %v", got, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestAllNodesReady(t *testing.T) {
+	readyNode := corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}}}
+	notReadyNode := corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}}}
+	noConditionNode := corev1.Node{}
+
+	tests := []struct {
+		name  string
+		nodes []corev1.Node
+		want  bool
+	}{
+		{name: "no nodes", nodes: nil, want: true},
+		{name: "all ready", nodes: []corev1.Node{readyNode, readyNode}, want: true},
+		{name: "one not ready", nodes: []corev1.Node{readyNode, notReadyNode}, want: false},
+		{name: "missing ready condition", nodes: []corev1.Node{noConditionNode}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allNodesReady(tt.nodes); got != tt.want {
+				t.Errorf("allNodesReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
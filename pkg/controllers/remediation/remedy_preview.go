@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+// syncRemedyPreview records, for a single cluster, the RemedyActions that would be
+// applied along with the Remedy objects and conditions that produced them, without
+// mutating cluster.Status.RemedyActions. It is only invoked when the controller runs
+// with DryRun enabled.
+func (c *RemedyController) syncRemedyPreview(ctx context.Context, cluster *clusterv1alpha1.Cluster, remedies []*remedyv1alpha1.Remedy, actions []remedyv1alpha1.RemedyAction) error {
+	preview := &remedyv1alpha1.RemedyPreview{}
+	err := c.Client.Get(ctx, client.ObjectKey{Name: cluster.Name}, preview)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		preview = &remedyv1alpha1.RemedyPreview{ObjectMeta: metav1.ObjectMeta{Name: cluster.Name}}
+		if err := c.Client.Create(ctx, preview); err != nil {
+			return err
+		}
+	}
+
+	status := remedyv1alpha1.RemedyPreviewStatus{
+		ObservedGeneration: cluster.Generation,
+		Actions:            actions,
+		MatchedRemedies:    remedyNames(remedies),
+		Conditions:         cluster.Status.Conditions,
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		preview.Status = status
+		updateErr := c.Client.Status().Update(ctx, preview)
+		if updateErr == nil {
+			return nil
+		}
+
+		updatedPreview := &remedyv1alpha1.RemedyPreview{}
+		if err := c.Client.Get(ctx, client.ObjectKey{Name: cluster.Name}, updatedPreview); err == nil {
+			preview = updatedPreview
+		}
+		return updateErr
+	})
+}
+
+func remedyNames(remedies []*remedyv1alpha1.Remedy) []string {
+	names := make([]string, 0, len(remedies))
+	for _, remedy := range remedies {
+		names = append(names, remedy.Name)
+	}
+	return names
+}
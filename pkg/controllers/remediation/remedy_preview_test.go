@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remediation
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	remedyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/remedy/v1alpha1"
+)
+
+// previewStoreClient is a minimal client.Client stand-in backing a single
+// named RemedyPreview, letting conflictingUpdates force Status().Update to
+// return a conflict a set number of times before succeeding.
+type previewStoreClient struct {
+	client.Client
+	preview            *remedyv1alpha1.RemedyPreview
+	conflictingUpdates int
+}
+
+func (f *previewStoreClient) Get(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if f.preview == nil {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "remedy.karmada.io", Resource: "remedypreviews"}, "")
+	}
+	*obj.(*remedyv1alpha1.RemedyPreview) = *f.preview
+	return nil
+}
+
+func (f *previewStoreClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	f.preview = obj.(*remedyv1alpha1.RemedyPreview)
+	return nil
+}
+
+func (f *previewStoreClient) Status() client.SubResourceWriter {
+	return &previewStoreStatusWriter{store: f}
+}
+
+type previewStoreStatusWriter struct {
+	client.SubResourceWriter
+	store *previewStoreClient
+}
+
+func (w *previewStoreStatusWriter) Update(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+	if w.store.conflictingUpdates > 0 {
+		w.store.conflictingUpdates--
+		return apierrors.NewConflict(schema.GroupResource{Group: "remedy.karmada.io", Resource: "remedypreviews"}, obj.GetName(), nil)
+	}
+	w.store.preview = obj.(*remedyv1alpha1.RemedyPreview)
+	return nil
+}
+
+func TestSyncRemedyPreview(t *testing.T) {
+	cluster := &clusterv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "member-1", Generation: 3},
+		Status:     clusterv1alpha1.ClusterStatus{Conditions: []metav1.Condition{{Type: clusterv1alpha1.ClusterConditionReady, Status: metav1.ConditionTrue}}},
+	}
+	remedies := []*remedyv1alpha1.Remedy{{ObjectMeta: metav1.ObjectMeta{Name: "high-cpu"}}}
+	actions := []remedyv1alpha1.RemedyAction{remedyv1alpha1.Cordon}
+
+	tests := []struct {
+		name    string
+		store   *previewStoreClient
+		wantErr bool
+	}{
+		{
+			name:  "creates a preview when none exists",
+			store: &previewStoreClient{},
+		},
+		{
+			name: "updates an existing preview",
+			store: &previewStoreClient{
+				preview: &remedyv1alpha1.RemedyPreview{ObjectMeta: metav1.ObjectMeta{Name: "member-1"}},
+			},
+		},
+		{
+			name: "retries once on a status update conflict",
+			store: &previewStoreClient{
+				preview:            &remedyv1alpha1.RemedyPreview{ObjectMeta: metav1.ObjectMeta{Name: "member-1"}},
+				conflictingUpdates: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &RemedyController{Client: tt.store}
+			err := c.syncRemedyPreview(context.Background(), cluster, remedies, actions)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("syncRemedyPreview() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.store.preview.Status.ObservedGeneration != cluster.Generation {
+				t.Errorf("ObservedGeneration = %d, want %d", tt.store.preview.Status.ObservedGeneration, cluster.Generation)
+			}
+			if got, want := tt.store.preview.Status.MatchedRemedies, remedyNames(remedies); len(got) != len(want) || got[0] != want[0] {
+				t.Errorf("MatchedRemedies = %v, want %v", got, want)
+			}
+			if tt.store.conflictingUpdates != 0 {
+				t.Errorf("conflictingUpdates = %d, want all consumed", tt.store.conflictingUpdates)
+			}
+		})
+	}
+}